@@ -18,10 +18,23 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/go-playground/locales"
 	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/it"
+	"github.com/go-playground/locales/ja"
+	"github.com/go-playground/locales/pt_BR"
+	"github.com/go-playground/locales/ru"
+	"github.com/go-playground/locales/zh"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
-	"go.uber.org/multierr"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	es_translations "github.com/go-playground/validator/v10/translations/es"
+	it_translations "github.com/go-playground/validator/v10/translations/it"
+	ja_translations "github.com/go-playground/validator/v10/translations/ja"
+	pt_BR_translations "github.com/go-playground/validator/v10/translations/pt_BR"
+	ru_translations "github.com/go-playground/validator/v10/translations/ru"
+	zh_translations "github.com/go-playground/validator/v10/translations/zh"
 )
 
 const (
@@ -31,8 +44,100 @@ const (
 	ConfigKeyTable = "table"
 	// ConfigKeyKey is the config name for a key.
 	ConfigKeyKey = "key"
+	// ConfigKeyLocale is the config name for the locale used to translate
+	// validation error messages.
+	ConfigKeyLocale = "locale"
+
+	// defaultLocale is used when ConfigKeyLocale is not set or not supported.
+	defaultLocale = "en"
 )
 
+// supportedLocales maps a locale tag to the go-playground/locales translator
+// used to seed the universal translator for that locale.
+var supportedLocales = map[string]locales.Translator{
+	"en":    en.New(),
+	"it":    it.New(),
+	"es":    es.New(),
+	"pt_BR": pt_BR.New(),
+	"zh":    zh.New(),
+	"ja":    ja.New(),
+	"ru":    ru.New(),
+}
+
+// defaultTranslationsByLocale maps a locale tag to validator/v10's built-in
+// translation bundle for that locale.
+var defaultTranslationsByLocale = map[string]func(*validator.Validate, ut.Translator) error{
+	"en":    en_translations.RegisterDefaultTranslations,
+	"it":    it_translations.RegisterDefaultTranslations,
+	"es":    es_translations.RegisterDefaultTranslations,
+	"pt_BR": pt_BR_translations.RegisterDefaultTranslations,
+	"zh":    zh_translations.RegisterDefaultTranslations,
+	"ja":    ja_translations.RegisterDefaultTranslations,
+	"ru":    ru_translations.RegisterDefaultTranslations,
+}
+
+// ValidationError represents a single failed validation on a Config field,
+// preserving the structured information validator/v10 exposes via
+// validator.FieldError instead of flattening it to a plain string.
+type ValidationError struct {
+	// Field is the name of the Config field that failed validation.
+	Field string
+	// Tag is the validation tag that failed (e.g. "required", "url", "max").
+	Tag string
+	// Param is the parameter associated with Tag, if any (e.g. "63" for "max=63").
+	Param string
+	// Value is the Field's value at the time validation failed.
+	Value any
+	// Message is the Tag's translated error message.
+	Message string
+}
+
+// Error returns the translated message, so a ValidationError can be used
+// wherever a plain error is expected.
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// ValidationErrors is the collection of ValidationError returned by
+// Config.Validate and Config.ValidateLocalized when one or more fields fail
+// validation.
+type ValidationErrors []ValidationError
+
+// Error joins the translated messages of every ValidationError in e.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, validationError := range e {
+		messages[i] = validationError.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Is reports whether e contains a ValidationError matching target's Field
+// and Tag, so callers can use errors.Is(err, config.ValidationError{Field: ..., Tag: ...}).
+func (e ValidationErrors) Is(target error) bool {
+	t, ok := target.(ValidationError)
+	if !ok {
+		return false
+	}
+	for _, validationError := range e {
+		if validationError.Field == t.Field && validationError.Tag == t.Tag {
+			return true
+		}
+	}
+	return false
+}
+
+// As supports errors.As(err, &config.ValidationError{}) by populating target
+// with the first ValidationError in e.
+func (e ValidationErrors) As(target any) bool {
+	t, ok := target.(*ValidationError)
+	if !ok || len(e) == 0 {
+		return false
+	}
+	*t = e[0]
+	return true
+}
+
 // Config represents configuration needed for Materialize.
 type Config struct {
 	URL string `validate:"required,url"`
@@ -40,15 +145,45 @@ type Config struct {
 	// See https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-SYNTAX-IDENTIFIERS.
 	Table string `validate:"max=63"`
 	Key   string `validate:"max=63"`
+
+	// Locale is the language validation error messages are translated into.
+	// Defaults to "en" when empty or unsupported.
+	Locale string `validate:"-"`
 }
 
-// Validate validates the Config.
+// Validate validates the Config, translating validation errors into the
+// locale configured on c (falling back to defaultLocale).
 func (c Config) Validate() error {
-	// init a translator and a universal translator
-	translator := en.New()
-	uni := ut.New(translator, translator)
+	return c.ValidateLocalized(c.Locale)
+}
+
+// ValidateLocalized validates the Config, translating any validation errors
+// into the given locale (e.g. "en", "it", "es", "pt_BR", "zh", "ja", "ru"),
+// regardless of the locale configured on c. An empty or unsupported locale
+// falls back to defaultLocale.
+func (c Config) ValidateLocalized(locale string) error {
+	if locale == "" {
+		locale = defaultLocale
+	}
 
-	uniTranslator, found := uni.GetTranslator("en")
+	translator, ok := supportedLocales[locale]
+	if !ok {
+		// an unsupported locale (e.g. an operator typo) falls back to
+		// defaultLocale, per this method's documented contract.
+		locale = defaultLocale
+		translator = supportedLocales[locale]
+	}
+
+	// seed the universal translator with every supported locale, with the
+	// requested one as its fallback; locale is always a supportedLocales key
+	// here, so GetTranslator below finds it by exact match.
+	fallbacks := make([]locales.Translator, 0, len(supportedLocales))
+	for _, t := range supportedLocales {
+		fallbacks = append(fallbacks, t)
+	}
+	uni := ut.New(translator, fallbacks...)
+
+	uniTranslator, found := uni.GetTranslator(locale)
 	if !found {
 		return errors.New("translator not found")
 	}
@@ -56,19 +191,31 @@ func (c Config) Validate() error {
 	// init a new instance of a validator
 	validate := validator.New()
 
-	// register custom translations
-	if err := registerTranslations(validate, uniTranslator); err != nil {
+	// register the built-in translations for the requested locale
+	if err := defaultTranslationsByLocale[locale](validate, uniTranslator); err != nil {
 		return err
 	}
 
-	// collect all validation errors into one
+	// Materialize-specific phrasing is only defined in English; other
+	// locales rely on validator's built-in bundle registered above.
+	if locale == defaultLocale {
+		if err := registerTranslations(validate, uniTranslator); err != nil {
+			return err
+		}
+	}
+
+	// collect all validation errors, preserving their structure
 	if err := validate.Struct(c); err != nil {
-		var resultErr error
-		validationErrors := err.(validator.ValidationErrors)
-		for _, validationError := range validationErrors {
-			resultErr = multierr.Append(resultErr, errors.New(
-				validationError.Translate(uniTranslator),
-			))
+		fieldErrors := err.(validator.ValidationErrors)
+		resultErr := make(ValidationErrors, len(fieldErrors))
+		for i, fieldError := range fieldErrors {
+			resultErr[i] = ValidationError{
+				Field:   fieldError.Field(),
+				Tag:     fieldError.Tag(),
+				Param:   fieldError.Param(),
+				Value:   fieldError.Value(),
+				Message: fieldError.Translate(uniTranslator),
+			}
 		}
 
 		return resultErr
@@ -117,10 +264,16 @@ func registerTranslations(validate *validator.Validate, uniTranslator ut.Transla
 
 // Parse attempts to parse plugins.Config into a Config struct
 func Parse(cfg map[string]string) (Config, error) {
+	locale := cfg[ConfigKeyLocale]
+	if locale == "" {
+		locale = defaultLocale
+	}
+
 	config := Config{
-		URL:   cfg[ConfigKeyURL],
-		Table: cfg[ConfigKeyTable],
-		Key:   cfg[ConfigKeyKey],
+		URL:    cfg[ConfigKeyURL],
+		Table:  cfg[ConfigKeyTable],
+		Key:    cfg[ConfigKeyKey],
+		Locale: locale,
 	}
 
 	if err := config.Validate(); err != nil {
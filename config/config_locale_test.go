@@ -0,0 +1,114 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConfig_ValidateLocalized(t *testing.T) {
+	invalid := Config{
+		URL:   "not-a-url",
+		Table: strings.Repeat("a", 64),
+		Key:   strings.Repeat("a", 64),
+	}
+
+	for _, locale := range []string{"en", "it", "es", "pt_BR", "zh", "ja", "ru"} {
+		locale := locale
+		t.Run(locale, func(t *testing.T) {
+			err := invalid.ValidateLocalized(locale)
+			if err == nil {
+				t.Fatal("expected a validation error, got nil")
+			}
+
+			validationErrors, ok := err.(ValidationErrors)
+			if !ok {
+				t.Fatalf("expected ValidationErrors, got %T", err)
+			}
+			if len(validationErrors) != 3 {
+				t.Fatalf("expected 3 validation errors (url, table, key), got %d: %v", len(validationErrors), validationErrors)
+			}
+			for _, validationError := range validationErrors {
+				if validationError.Message == "" {
+					t.Errorf("field %q: expected a translated message, got empty string", validationError.Field)
+				}
+			}
+		})
+	}
+
+	valid := Config{URL: "https://materialize.example.com"}
+	if err := valid.ValidateLocalized("en"); err != nil {
+		t.Fatalf("expected no error for a valid config, got %v", err)
+	}
+}
+
+func TestConfig_ValidateLocalized_UnsupportedLocaleFallsBackToDefault(t *testing.T) {
+	invalid := Config{URL: ""}
+
+	got := invalid.ValidateLocalized("fr")
+	want := invalid.ValidateLocalized(defaultLocale)
+
+	if got == nil || want == nil {
+		t.Fatal("expected both calls to return a validation error")
+	}
+	if got.Error() != want.Error() {
+		t.Fatalf("unsupported locale should fall back to %q, got message %q, want %q", defaultLocale, got.Error(), want.Error())
+	}
+}
+
+func TestConfig_Validate_UsesConfiguredLocale(t *testing.T) {
+	c := Config{URL: "", Locale: "es"}
+
+	err := c.Validate()
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+
+	localized := (Config{URL: ""}).ValidateLocalized("es")
+	if err.Error() != localized.Error() {
+		t.Fatalf("Validate() did not honor c.Locale: got %q, want %q", err.Error(), localized.Error())
+	}
+}
+
+func TestParse_DefaultsLocaleToEnglish(t *testing.T) {
+	_, err := Parse(map[string]string{ConfigKeyURL: "not-a-url"})
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+
+	validationErrors, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	for _, validationError := range validationErrors {
+		if validationError.Tag == "url" && !strings.Contains(validationError.Message, "valid url") {
+			t.Errorf("expected the default (en) message, got %q", validationError.Message)
+		}
+	}
+}
+
+func TestParse_HonorsConfiguredLocale(t *testing.T) {
+	config, err := Parse(map[string]string{
+		ConfigKeyURL:    "https://materialize.example.com",
+		ConfigKeyLocale: "it",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if config.Locale != "it" {
+		t.Fatalf("expected Locale %q to be preserved, got %q", "it", config.Locale)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright © 2022 Meroxa, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestConfig_Validate_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   ValidationError
+	}{
+		{
+			name:   "required",
+			config: Config{URL: "", Table: "orders", Key: "id"},
+			want:   ValidationError{Field: "URL", Tag: "required", Message: `"url" config value must be set`},
+		},
+		{
+			name:   "url",
+			config: Config{URL: "not-a-url", Table: "orders", Key: "id"},
+			want:   ValidationError{Field: "URL", Tag: "url", Message: `"url" config value must be a valid url`},
+		},
+		{
+			name:   "max",
+			config: Config{URL: "https://materialize.example.com", Table: strings.Repeat("a", 64), Key: "id"},
+			want:   ValidationError{Field: "Table", Tag: "max", Param: "63", Message: `"table" config value is too long`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if err == nil {
+				t.Fatal("expected a validation error, got nil")
+			}
+
+			validationErrors, ok := err.(ValidationErrors)
+			if !ok {
+				t.Fatalf("expected ValidationErrors, got %T", err)
+			}
+
+			var got ValidationError
+			var found bool
+			for _, validationError := range validationErrors {
+				if validationError.Field == tt.want.Field && validationError.Tag == tt.want.Tag {
+					got = validationError
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("no ValidationError for field %q tag %q in %v", tt.want.Field, tt.want.Tag, validationErrors)
+			}
+
+			if got.Param != tt.want.Param {
+				t.Errorf("Param: got %q, want %q", got.Param, tt.want.Param)
+			}
+			if got.Message != tt.want.Message {
+				t.Errorf("Message: got %q, want %q", got.Message, tt.want.Message)
+			}
+		})
+	}
+}
+
+func TestValidationErrors_Error(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "URL", Tag: "required", Message: `"url" config value must be set`},
+		{Field: "Table", Tag: "max", Message: `"table" config value is too long`},
+	}
+
+	want := `"url" config value must be set; "table" config value is too long`
+	if got := errs.Error(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidationErrors_Is(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "URL", Tag: "required", Message: `"url" config value must be set`},
+	}
+
+	if !errors.Is(errs, ValidationError{Field: "URL", Tag: "required"}) {
+		t.Error("expected errors.Is to match an equivalent Field/Tag")
+	}
+	if errors.Is(errs, ValidationError{Field: "URL", Tag: "url"}) {
+		t.Error("expected errors.Is to not match a different Tag")
+	}
+}
+
+func TestValidationErrors_As(t *testing.T) {
+	errs := ValidationErrors{
+		{Field: "URL", Tag: "required", Message: `"url" config value must be set`},
+	}
+
+	var target ValidationError
+	if !errors.As(errs, &target) {
+		t.Fatal("expected errors.As to succeed")
+	}
+	if target.Field != "URL" || target.Tag != "required" {
+		t.Fatalf("got %+v, want Field=URL Tag=required", target)
+	}
+}